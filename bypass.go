@@ -0,0 +1,55 @@
+package logh
+
+//
+// Opt-in switches that turn Panic()/Fatal() into safe no-ops, so tests and
+// long-running daemons can exercise error paths without dying.
+// @author rnojiri
+//
+
+// panicBypassMarker - the field value added to a bypassed panic event
+const panicBypassMarker string = "[PANIC BYPASSED]"
+
+// fatalBypassMarker - the field value added to a bypassed fatal event
+const fatalBypassMarker string = "[FATAL BYPASSED]"
+
+var (
+	noPanicsGlobal bool
+	noFatalsGlobal bool
+)
+
+// ConfigOption - configures global bypass behavior for ConfigureCustomLogger
+type ConfigOption func()
+
+// WithNoPanics - makes the top-level Panic() and every ContextualLogger.Panic()
+// emit at error level instead of panicking
+func WithNoPanics() ConfigOption {
+
+	return func() {
+		noPanicsGlobal = true
+	}
+}
+
+// WithNoFatals - makes the top-level Fatal() and every ContextualLogger.Fatal()
+// emit at error level instead of calling os.Exit
+func WithNoFatals() ConfigOption {
+
+	return func() {
+		noFatalsGlobal = true
+	}
+}
+
+// WithNoPanics - makes this logger's Panic() emit at error level instead of panicking
+func (cl *ContextualLogger) WithNoPanics() *ContextualLogger {
+
+	cl.noPanics = true
+
+	return cl
+}
+
+// WithNoFatals - makes this logger's Fatal() emit at error level instead of calling os.Exit
+func (cl *ContextualLogger) WithNoFatals() *ContextualLogger {
+
+	cl.noFatals = true
+
+	return cl
+}
@@ -0,0 +1,55 @@
+package logh
+
+import "context"
+
+//
+// Propagates a ContextualLogger through a context.Context, so request-scoped
+// fields (trace IDs, user IDs, ...) can flow through handler chains without
+// threading *ContextualLogger explicitly.
+// @author rnojiri
+//
+
+type contextKey struct{}
+
+var loggerContextKey = contextKey{}
+
+// NewContext - returns a copy of ctx carrying cl, unless ctx already carries
+// this exact logger, in which case ctx is returned unchanged
+func NewContext(ctx context.Context, cl *ContextualLogger) context.Context {
+
+	if existing, ok := ctx.Value(loggerContextKey).(*ContextualLogger); ok && existing == cl {
+		return ctx
+	}
+
+	return context.WithValue(ctx, loggerContextKey, cl)
+}
+
+// FromContext - returns the ContextualLogger stored in ctx, if any
+func FromContext(ctx context.Context) *ContextualLogger {
+
+	cl, _ := ctx.Value(loggerContextKey).(*ContextualLogger)
+
+	return cl
+}
+
+// WithContext - stores this logger in ctx, unless ctx already carries a
+// logger and it is not this one, preserving a parent context's logger
+func (cl *ContextualLogger) WithContext(ctx context.Context) context.Context {
+
+	if existing, ok := ctx.Value(loggerContextKey).(*ContextualLogger); ok && existing != nil {
+		return ctx
+	}
+
+	return NewContext(ctx, cl)
+}
+
+// CreateFromContextCtx - pulls the parent logger from ctx and appends fields to a copy of it
+func CreateFromContextCtx(ctx context.Context, keyValues ...interface{}) (*ContextualLogger, error) {
+
+	parent := FromContext(ctx)
+	if parent == nil {
+		return CreateContextualLogger(keyValues...), nil
+	}
+
+	return parent.CreateFromContext(keyValues...)
+}
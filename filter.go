@@ -0,0 +1,178 @@
+package logh
+
+import "reflect"
+
+//
+// A filter subsystem that can be attached to the global logger or to a
+// ContextualLogger to centralize level gating, key/value redaction and
+// custom drop rules before events reach zerolog.
+// @author rnojiri
+//
+
+// redactedPlaceholder - the fuzzy value used to replace redacted fields
+const redactedPlaceholder string = "***"
+
+// levelPriority - the relative severity of each level, lowest first
+var levelPriority = map[Level]int{
+	DEBUG: 0,
+	INFO:  1,
+	WARN:  2,
+	ERROR: 3,
+	FATAL: 4,
+	PANIC: 5,
+}
+
+// FilterOption - configures a Filter
+type FilterOption func(*Filter)
+
+// Filter - intercepts events before they are dispatched to zerolog
+type Filter struct {
+	hasLevel     bool
+	level        Level
+	redactKeys   map[string]struct{}
+	redactValues map[interface{}]struct{}
+	dropFunc     func(Level, ...interface{}) bool
+}
+
+// NewFilter - creates a new filter using the given options
+func NewFilter(options ...FilterOption) *Filter {
+
+	f := &Filter{
+		redactKeys:   map[string]struct{}{},
+		redactValues: map[interface{}]struct{}{},
+	}
+
+	for _, opt := range options {
+		opt(f)
+	}
+
+	return f
+}
+
+// FilterLevel - drops events below the given level
+func FilterLevel(lvl Level) FilterOption {
+
+	return func(f *Filter) {
+		f.hasLevel = true
+		f.level = lvl
+	}
+}
+
+// FilterKey - redacts the values of the given keys, replacing them with a fuzzy placeholder
+func FilterKey(keys ...string) FilterOption {
+
+	return func(f *Filter) {
+		for _, k := range keys {
+			f.redactKeys[k] = struct{}{}
+		}
+	}
+}
+
+// FilterValue - redacts the given values wherever they appear, regardless of the key.
+// Non-comparable values (slices, maps, ...) cannot be used as map keys and are
+// silently skipped instead of panicking at registration time.
+func FilterValue(values ...interface{}) FilterOption {
+
+	return func(f *Filter) {
+		for _, v := range values {
+			if isComparable(v) {
+				f.redactValues[v] = struct{}{}
+			}
+		}
+	}
+}
+
+// FilterFunc - fully drops events for which fn returns true
+func FilterFunc(fn func(Level, ...interface{}) bool) FilterOption {
+
+	return func(f *Filter) {
+		f.dropFunc = fn
+	}
+}
+
+// allows - returns false when lvl must be dropped by this filter
+func (f *Filter) allows(lvl Level, keyValues ...interface{}) bool {
+
+	if f == nil {
+		return true
+	}
+
+	if f.hasLevel {
+		p, hasP := levelPriority[lvl]
+		lp, hasLP := levelPriority[f.level]
+		if hasP && hasLP && p < lp {
+			return false
+		}
+	}
+
+	if f.dropFunc != nil && f.dropFunc(lvl, keyValues...) {
+		return false
+	}
+
+	return true
+}
+
+// redact - replaces denylisted keys/values with a fuzzy placeholder
+func (f *Filter) redact(kvs []keyValue) []keyValue {
+
+	if f == nil || (len(f.redactKeys) == 0 && len(f.redactValues) == 0) {
+		return kvs
+	}
+
+	out := make([]keyValue, len(kvs))
+
+	for i, kv := range kvs {
+
+		if _, ok := f.redactKeys[kv.key]; ok {
+			out[i] = newItem(kv.key, redactedPlaceholder)
+			continue
+		}
+
+		if isComparable(kv.value) {
+			if _, ok := f.redactValues[kv.value]; ok {
+				out[i] = newItem(kv.key, redactedPlaceholder)
+				continue
+			}
+		}
+
+		out[i] = kv
+	}
+
+	return out
+}
+
+// isComparable - tells if v can be safely used as a map key, since
+// f.redactValues is keyed directly by the logged value and slices, maps and
+// some structs are not comparable
+func isComparable(v interface{}) bool {
+
+	if v == nil {
+		return true
+	}
+
+	return reflect.TypeOf(v).Comparable()
+}
+
+// globalFilter - the filter attached to the global logger, if any
+var globalFilter *Filter
+
+// SetGlobalFilter - attaches a filter to the global logger
+func SetGlobalFilter(f *Filter) {
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	globalFilter = f
+}
+
+// SetFilter - attaches a filter to this logger
+func (cl *ContextualLogger) SetFilter(f *Filter) {
+
+	cl.filter = f
+}
+
+// filterAllows - checks this logger's filter against the given level
+func (cl *ContextualLogger) filterAllows(lvl Level) bool {
+
+	return cl.filter.allows(lvl, cl.GetContexts()...)
+}
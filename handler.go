@@ -0,0 +1,167 @@
+package logh
+
+import "reflect"
+
+//
+// A Handler abstracts the logging backend so ContextualLogger can be backed
+// by something other than zerolog (slog, logr, logrus, zap, ...) while
+// keeping the same contextual API and ErrorLine caller enrichment.
+// @author rnojiri
+//
+
+// Handler - abstracts a structured logging backend
+type Handler interface {
+
+	// Enabled - tells if the given level would produce an event
+	Enabled(lvl Level) bool
+
+	// Event - starts a new event for the given level, or nil if disabled
+	Event(lvl Level) HandlerEvent
+}
+
+// HandlerEvent - abstracts a single structured log event emitted by a Handler.
+// Implementations must be nil-safe: every method must tolerate a nil receiver
+// and return nil, mirroring zerolog.Event's chaining behavior.
+type HandlerEvent interface {
+	Str(key, value string) HandlerEvent
+	Int(key string, value int) HandlerEvent
+	Int64(key string, value int64) HandlerEvent
+	Uint64(key string, value uint64) HandlerEvent
+	Float64(key string, value float64) HandlerEvent
+	Bool(key string, value bool) HandlerEvent
+	Interface(key string, value interface{}) HandlerEvent
+	Err(err error) HandlerEvent
+	Msg(msg string)
+}
+
+// activeHandler - the handler backing HEvent and ContextualLogger.HEvent, if configured
+var activeHandler Handler
+
+// activeHandlerLevel - the minimum level enabled on activeHandler, as given to ConfigureHandler
+var activeHandlerLevel Level
+
+// ConfigureHandler - configures the custom Handler used by HEvent and
+// ContextualLogger.HEvent, and sets the *Enabled flags from it. Info(), Debug()
+// and the other *zerolog.Event accessors keep writing to the zerolog logger.
+func ConfigureHandler(lvl Level, h Handler) {
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	activeHandler = h
+	activeHandlerLevel = lvl
+
+	InfoEnabled = h.Enabled(INFO) && handlerLevelAllows(INFO)
+	DebugEnabled = h.Enabled(DEBUG) && handlerLevelAllows(DEBUG)
+	WarnEnabled = h.Enabled(WARN) && handlerLevelAllows(WARN)
+	ErrorEnabled = h.Enabled(ERROR) && handlerLevelAllows(ERROR)
+	FatalEnabled = h.Enabled(FATAL) && handlerLevelAllows(FATAL)
+	PanicEnabled = h.Enabled(PANIC) && handlerLevelAllows(PANIC)
+}
+
+// handlerLevelAllows - tells if lvl is enabled by the level configured via ConfigureHandler
+func handlerLevelAllows(lvl Level) bool {
+
+	switch activeHandlerLevel {
+	case "", NONE:
+		return true
+	case SILENT:
+		return false
+	}
+
+	p, hasP := levelPriority[lvl]
+	minP, hasMin := levelPriority[activeHandlerLevel]
+
+	if hasP && hasMin && p < minP {
+		return false
+	}
+
+	return true
+}
+
+// dispatchHandlerEvent - returns an event from the active handler, downgrading
+// PANIC/FATAL to ERROR with a bypass marker when bypass is enabled, mirroring
+// what the zerolog-backed Fatal()/Panic() already do
+func dispatchHandlerEvent(lvl Level, noPanics, noFatals bool) HandlerEvent {
+
+	if lvl == PANIC && (noPanics || noPanicsGlobal) {
+		return activeHandler.Event(ERROR).Str("@bypass", panicBypassMarker)
+	}
+
+	if lvl == FATAL && (noFatals || noFatalsGlobal) {
+		return activeHandler.Event(ERROR).Str("@bypass", fatalBypassMarker)
+	}
+
+	return activeHandler.Event(lvl)
+}
+
+// HEvent - returns the event for the given level using the configured handler
+func HEvent(lvl Level) HandlerEvent {
+
+	if activeHandler == nil {
+		return nil
+	}
+
+	if !globalFilter.allows(lvl) || !handlerLevelAllows(lvl) {
+		return nil
+	}
+
+	return dispatchHandlerEvent(lvl, false, false)
+}
+
+// HEvent - returns the event for the given level using the configured context
+func (cl *ContextualLogger) HEvent(lvl Level) HandlerEvent {
+
+	if !cl.filterAllows(lvl) || !cl.sampler.allow(lvl) {
+		return nil
+	}
+
+	if activeHandler == nil {
+		return nil
+	}
+
+	if !globalFilter.allows(lvl) || !handlerLevelAllows(lvl) {
+		return nil
+	}
+
+	return cl.addContextH(dispatchHandlerEvent(lvl, cl.noPanics, cl.noFatals))
+}
+
+// addContextH - add this logger's context to a HandlerEvent
+func (cl *ContextualLogger) addContextH(ev HandlerEvent) HandlerEvent {
+
+	if ev == nil {
+		return nil
+	}
+
+	kvs := cl.filter.redact(cl.keyValues)
+
+	for _, kv := range kvs {
+
+		switch kv.kind {
+
+		case reflect.String:
+			ev = ev.Str(kv.key, kv.rvalue.String())
+
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+			ev = ev.Int(kv.key, int(kv.rvalue.Int()))
+
+		case reflect.Int64:
+			ev = ev.Int64(kv.key, kv.rvalue.Int())
+
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			ev = ev.Uint64(kv.key, kv.rvalue.Uint())
+
+		case reflect.Float32, reflect.Float64:
+			ev = ev.Float64(kv.key, kv.rvalue.Float())
+
+		case reflect.Bool:
+			ev = ev.Bool(kv.key, kv.rvalue.Bool())
+
+		default:
+			ev = ev.Interface(kv.key, kv.value)
+		}
+	}
+
+	return ev
+}
@@ -0,0 +1,136 @@
+package logh
+
+import (
+	"os"
+
+	"github.com/go-logr/logr"
+)
+
+// LogrHandler - a Handler backed by a logr.Logger
+type LogrHandler struct {
+	logger logr.Logger
+}
+
+// NewLogrHandler - creates a new handler backed by the given logr.Logger
+func NewLogrHandler(l logr.Logger) *LogrHandler {
+
+	return &LogrHandler{logger: l}
+}
+
+// Enabled - tells if the given level would produce an event
+func (h *LogrHandler) Enabled(lvl Level) bool {
+
+	if lvl == ERROR || lvl == FATAL || lvl == PANIC {
+		return true
+	}
+
+	return h.logger.Enabled()
+}
+
+// Event - starts a new event for the given level, or nil if disabled
+func (h *LogrHandler) Event(lvl Level) HandlerEvent {
+
+	if !h.Enabled(lvl) {
+		return nil
+	}
+
+	return &logrHandlerEvent{logger: h.logger, lvl: lvl}
+}
+
+// logrHandlerEvent - adapts a logr.Logger call to the HandlerEvent interface.
+// logr has no structured "error" level distinct from Info, so ERROR/FATAL/PANIC
+// are routed through logr.Logger.Error. logr also has no notion of terminating
+// the process, unlike the zerolog/zap/logrus backends whose native Fatal/Panic
+// calls already do so, so Msg replicates that contract here: it panics or calls
+// os.Exit itself once the underlying logr call returns. dispatchHandlerEvent
+// already downgrades bypassed PANIC/FATAL to ERROR before Event is reached, so
+// lvl is only ever PANIC/FATAL here when the caller did not opt out.
+type logrHandlerEvent struct {
+	logger logr.Logger
+	lvl    Level
+	err    error
+	kvs    []interface{}
+}
+
+func (e *logrHandlerEvent) Str(key, value string) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.kvs = append(e.kvs, key, value)
+	return e
+}
+
+func (e *logrHandlerEvent) Int(key string, value int) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.kvs = append(e.kvs, key, value)
+	return e
+}
+
+func (e *logrHandlerEvent) Int64(key string, value int64) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.kvs = append(e.kvs, key, value)
+	return e
+}
+
+func (e *logrHandlerEvent) Uint64(key string, value uint64) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.kvs = append(e.kvs, key, value)
+	return e
+}
+
+func (e *logrHandlerEvent) Float64(key string, value float64) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.kvs = append(e.kvs, key, value)
+	return e
+}
+
+func (e *logrHandlerEvent) Bool(key string, value bool) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.kvs = append(e.kvs, key, value)
+	return e
+}
+
+func (e *logrHandlerEvent) Interface(key string, value interface{}) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.kvs = append(e.kvs, key, value)
+	return e
+}
+
+func (e *logrHandlerEvent) Err(err error) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.err = err
+	return e
+}
+
+func (e *logrHandlerEvent) Msg(msg string) {
+	if e == nil {
+		return
+	}
+
+	if e.lvl == ERROR || e.lvl == FATAL || e.lvl == PANIC {
+		e.logger.Error(e.err, msg, e.kvs...)
+	} else {
+		e.logger.Info(msg, e.kvs...)
+	}
+
+	switch e.lvl {
+	case PANIC:
+		panic(msg)
+	case FATAL:
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,130 @@
+package logh
+
+import "github.com/sirupsen/logrus"
+
+// LogrusHandler - a Handler backed by a *logrus.Logger
+type LogrusHandler struct {
+	logger *logrus.Logger
+}
+
+// NewLogrusHandler - creates a new handler backed by the given *logrus.Logger
+func NewLogrusHandler(l *logrus.Logger) *LogrusHandler {
+
+	return &LogrusHandler{logger: l}
+}
+
+func (h *LogrusHandler) level(lvl Level) logrus.Level {
+
+	switch lvl {
+	case DEBUG:
+		return logrus.DebugLevel
+	case WARN:
+		return logrus.WarnLevel
+	case ERROR:
+		return logrus.ErrorLevel
+	case FATAL:
+		return logrus.FatalLevel
+	case PANIC:
+		return logrus.PanicLevel
+	default:
+		return logrus.InfoLevel
+	}
+}
+
+// Enabled - tells if the given level would produce an event
+func (h *LogrusHandler) Enabled(lvl Level) bool {
+
+	return h.logger.IsLevelEnabled(h.level(lvl))
+}
+
+// Event - starts a new event for the given level, or nil if disabled
+func (h *LogrusHandler) Event(lvl Level) HandlerEvent {
+
+	if !h.Enabled(lvl) {
+		return nil
+	}
+
+	return &logrusHandlerEvent{entry: logrus.NewEntry(h.logger), level: h.level(lvl)}
+}
+
+// logrusHandlerEvent - adapts a *logrus.Entry to the HandlerEvent interface
+type logrusHandlerEvent struct {
+	entry *logrus.Entry
+	level logrus.Level
+}
+
+func (e *logrusHandlerEvent) Str(key, value string) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.entry = e.entry.WithField(key, value)
+	return e
+}
+
+func (e *logrusHandlerEvent) Int(key string, value int) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.entry = e.entry.WithField(key, value)
+	return e
+}
+
+func (e *logrusHandlerEvent) Int64(key string, value int64) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.entry = e.entry.WithField(key, value)
+	return e
+}
+
+func (e *logrusHandlerEvent) Uint64(key string, value uint64) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.entry = e.entry.WithField(key, value)
+	return e
+}
+
+func (e *logrusHandlerEvent) Float64(key string, value float64) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.entry = e.entry.WithField(key, value)
+	return e
+}
+
+func (e *logrusHandlerEvent) Bool(key string, value bool) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.entry = e.entry.WithField(key, value)
+	return e
+}
+
+func (e *logrusHandlerEvent) Interface(key string, value interface{}) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.entry = e.entry.WithField(key, value)
+	return e
+}
+
+func (e *logrusHandlerEvent) Err(err error) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.entry = e.entry.WithError(err)
+	return e
+}
+
+func (e *logrusHandlerEvent) Msg(msg string) {
+	if e == nil {
+		return
+	}
+	e.entry.Log(e.level, msg)
+
+	// Entry.Log panics at PanicLevel but does not exit at FatalLevel
+	if e.level == logrus.FatalLevel {
+		e.entry.Logger.Exit(1)
+	}
+}
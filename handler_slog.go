@@ -0,0 +1,122 @@
+package logh
+
+import "log/slog"
+
+// SlogHandler - a Handler backed by a *slog.Logger
+type SlogHandler struct {
+	logger *slog.Logger
+}
+
+// NewSlogHandler - creates a new handler backed by the given *slog.Logger
+func NewSlogHandler(l *slog.Logger) *SlogHandler {
+
+	return &SlogHandler{logger: l}
+}
+
+func (h *SlogHandler) level(lvl Level) slog.Level {
+
+	switch lvl {
+	case DEBUG:
+		return slog.LevelDebug
+	case WARN:
+		return slog.LevelWarn
+	case ERROR, FATAL, PANIC:
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// Enabled - tells if the given level would produce an event
+func (h *SlogHandler) Enabled(lvl Level) bool {
+
+	return h.logger.Enabled(nil, h.level(lvl))
+}
+
+// Event - starts a new event for the given level, or nil if disabled
+func (h *SlogHandler) Event(lvl Level) HandlerEvent {
+
+	if !h.Enabled(lvl) {
+		return nil
+	}
+
+	return &slogHandlerEvent{logger: h.logger, level: h.level(lvl)}
+}
+
+// slogHandlerEvent - adapts a *slog.Logger call to the HandlerEvent interface
+type slogHandlerEvent struct {
+	logger *slog.Logger
+	level  slog.Level
+	attrs  []any
+}
+
+func (e *slogHandlerEvent) Str(key, value string) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.attrs = append(e.attrs, key, value)
+	return e
+}
+
+func (e *slogHandlerEvent) Int(key string, value int) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.attrs = append(e.attrs, key, value)
+	return e
+}
+
+func (e *slogHandlerEvent) Int64(key string, value int64) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.attrs = append(e.attrs, key, value)
+	return e
+}
+
+func (e *slogHandlerEvent) Uint64(key string, value uint64) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.attrs = append(e.attrs, key, value)
+	return e
+}
+
+func (e *slogHandlerEvent) Float64(key string, value float64) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.attrs = append(e.attrs, key, value)
+	return e
+}
+
+func (e *slogHandlerEvent) Bool(key string, value bool) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.attrs = append(e.attrs, key, value)
+	return e
+}
+
+func (e *slogHandlerEvent) Interface(key string, value interface{}) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.attrs = append(e.attrs, key, value)
+	return e
+}
+
+func (e *slogHandlerEvent) Err(err error) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.attrs = append(e.attrs, "error", err)
+	return e
+}
+
+func (e *slogHandlerEvent) Msg(msg string) {
+	if e == nil {
+		return
+	}
+	e.logger.Log(nil, e.level, msg, e.attrs...)
+}
@@ -0,0 +1,132 @@
+package logh
+
+import (
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// ZapHandler - a Handler backed by a *zap.Logger
+type ZapHandler struct {
+	logger *zap.Logger
+}
+
+// NewZapHandler - creates a new handler backed by the given *zap.Logger
+func NewZapHandler(l *zap.Logger) *ZapHandler {
+
+	return &ZapHandler{logger: l}
+}
+
+func (h *ZapHandler) level(lvl Level) zapcore.Level {
+
+	switch lvl {
+	case DEBUG:
+		return zapcore.DebugLevel
+	case WARN:
+		return zapcore.WarnLevel
+	case ERROR:
+		return zapcore.ErrorLevel
+	case FATAL:
+		return zapcore.FatalLevel
+	case PANIC:
+		return zapcore.PanicLevel
+	default:
+		return zapcore.InfoLevel
+	}
+}
+
+// Enabled - tells if the given level would produce an event
+func (h *ZapHandler) Enabled(lvl Level) bool {
+
+	return h.logger.Core().Enabled(h.level(lvl))
+}
+
+// Event - starts a new event for the given level, or nil if disabled
+func (h *ZapHandler) Event(lvl Level) HandlerEvent {
+
+	zlvl := h.level(lvl)
+	if !h.logger.Core().Enabled(zlvl) {
+		return nil
+	}
+
+	return &zapHandlerEvent{logger: h.logger, level: zlvl}
+}
+
+// zapHandlerEvent - adapts a *zap.Logger call to the HandlerEvent interface
+type zapHandlerEvent struct {
+	logger *zap.Logger
+	level  zapcore.Level
+	fields []zap.Field
+}
+
+func (e *zapHandlerEvent) Str(key, value string) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.fields = append(e.fields, zap.String(key, value))
+	return e
+}
+
+func (e *zapHandlerEvent) Int(key string, value int) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.fields = append(e.fields, zap.Int(key, value))
+	return e
+}
+
+func (e *zapHandlerEvent) Int64(key string, value int64) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.fields = append(e.fields, zap.Int64(key, value))
+	return e
+}
+
+func (e *zapHandlerEvent) Uint64(key string, value uint64) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.fields = append(e.fields, zap.Uint64(key, value))
+	return e
+}
+
+func (e *zapHandlerEvent) Float64(key string, value float64) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.fields = append(e.fields, zap.Float64(key, value))
+	return e
+}
+
+func (e *zapHandlerEvent) Bool(key string, value bool) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.fields = append(e.fields, zap.Bool(key, value))
+	return e
+}
+
+func (e *zapHandlerEvent) Interface(key string, value interface{}) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.fields = append(e.fields, zap.Any(key, value))
+	return e
+}
+
+func (e *zapHandlerEvent) Err(err error) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.fields = append(e.fields, zap.Error(err))
+	return e
+}
+
+func (e *zapHandlerEvent) Msg(msg string) {
+	if e == nil {
+		return
+	}
+	if ce := e.logger.Check(e.level, msg); ce != nil {
+		ce.Write(e.fields...)
+	}
+}
@@ -0,0 +1,130 @@
+package logh
+
+import "github.com/rs/zerolog"
+
+//
+// The default Handler implementation, backed by the package's own zerolog
+// logger. Kept mainly as a reference adapter for the other backends.
+//
+
+// ZerologHandler - a Handler backed by a zerolog.Logger
+type ZerologHandler struct {
+	logger *zerolog.Logger
+}
+
+// NewZerologHandler - creates a new handler backed by the given zerolog.Logger
+func NewZerologHandler(l *zerolog.Logger) *ZerologHandler {
+
+	return &ZerologHandler{logger: l}
+}
+
+// Enabled - tells if the given level would produce an event
+func (h *ZerologHandler) Enabled(lvl Level) bool {
+
+	return h.eventFor(lvl).Enabled()
+}
+
+// Event - starts a new event for the given level, or nil if disabled
+func (h *ZerologHandler) Event(lvl Level) HandlerEvent {
+
+	ev := h.eventFor(lvl)
+	if !ev.Enabled() {
+		return nil
+	}
+
+	return &zerologHandlerEvent{ev: ev}
+}
+
+func (h *ZerologHandler) eventFor(lvl Level) *zerolog.Event {
+
+	switch lvl {
+	case DEBUG:
+		return h.logger.Debug()
+	case WARN:
+		return h.logger.Warn()
+	case ERROR:
+		return h.logger.Error()
+	case FATAL:
+		return h.logger.Fatal()
+	case PANIC:
+		return h.logger.Panic()
+	default:
+		return h.logger.Info()
+	}
+}
+
+// zerologHandlerEvent - adapts a *zerolog.Event to the HandlerEvent interface
+type zerologHandlerEvent struct {
+	ev *zerolog.Event
+}
+
+func (e *zerologHandlerEvent) Str(key, value string) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.ev = e.ev.Str(key, value)
+	return e
+}
+
+func (e *zerologHandlerEvent) Int(key string, value int) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.ev = e.ev.Int(key, value)
+	return e
+}
+
+func (e *zerologHandlerEvent) Int64(key string, value int64) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.ev = e.ev.Int64(key, value)
+	return e
+}
+
+func (e *zerologHandlerEvent) Uint64(key string, value uint64) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.ev = e.ev.Uint64(key, value)
+	return e
+}
+
+func (e *zerologHandlerEvent) Float64(key string, value float64) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.ev = e.ev.Float64(key, value)
+	return e
+}
+
+func (e *zerologHandlerEvent) Bool(key string, value bool) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.ev = e.ev.Bool(key, value)
+	return e
+}
+
+func (e *zerologHandlerEvent) Interface(key string, value interface{}) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.ev = e.ev.Interface(key, value)
+	return e
+}
+
+func (e *zerologHandlerEvent) Err(err error) HandlerEvent {
+	if e == nil {
+		return nil
+	}
+	e.ev = e.ev.Err(err)
+	return e
+}
+
+func (e *zerologHandlerEvent) Msg(msg string) {
+	if e == nil {
+		return
+	}
+	e.ev.Msg(msg)
+}
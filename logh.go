@@ -129,25 +129,41 @@ func (s byKey) Swap(i, j int) {
 // ContextualLogger - a struct containing all valid event loggers (each one can be null if not enabled)
 type ContextualLogger struct {
 	keyValues []keyValue
+	filter    *Filter
+	noPanics  bool
+	noFatals  bool
+	sampler   *sampler
 }
 
 // Info - returns the event logger using the configured context
 func (cl *ContextualLogger) Info() *zerolog.Event {
+	if !cl.filterAllows(INFO) || !cl.sampler.allow(INFO) {
+		return nil
+	}
 	return cl.addContext(Info())
 }
 
 // Debug - returns the event logger using the configured context
 func (cl *ContextualLogger) Debug() *zerolog.Event {
+	if !cl.filterAllows(DEBUG) || !cl.sampler.allow(DEBUG) {
+		return nil
+	}
 	return cl.addContext(Debug())
 }
 
 // Warn - returns the event logger using the configured context
 func (cl *ContextualLogger) Warn() *zerolog.Event {
+	if !cl.filterAllows(WARN) || !cl.sampler.allow(WARN) {
+		return nil
+	}
 	return cl.addContext(Warn())
 }
 
 // Error - returns the event logger using the configured context
 func (cl *ContextualLogger) Error() *zerolog.Event {
+	if !cl.filterAllows(ERROR) || !cl.sampler.allow(ERROR) {
+		return nil
+	}
 	return cl.addContext(Error())
 }
 
@@ -160,6 +176,10 @@ func (cl *ContextualLogger) ErrorLine() *zerolog.Event {
 // ErrorLineC - returns the event logger using the configured context
 func (cl *ContextualLogger) ErrorLineC(skippedStackFrames int) *zerolog.Event {
 
+	if !cl.filterAllows(ERROR) || !cl.sampler.allow(ERROR) {
+		return nil
+	}
+
 	_, filename, line, ok := runtime.Caller(skippedStackFrames)
 	ev := Error()
 	if !ok {
@@ -178,26 +198,42 @@ func (cl *ContextualLogger) ErrorLineC(skippedStackFrames int) *zerolog.Event {
 
 // Fatal - returns the event logger using the configured context
 func (cl *ContextualLogger) Fatal() *zerolog.Event {
+	if !cl.filterAllows(FATAL) {
+		return nil
+	}
+	if cl.noFatals || noFatalsGlobal {
+		return cl.addContext(Error()).Str("@bypass", fatalBypassMarker)
+	}
 	return cl.addContext(Fatal())
 }
 
 // Panic - returns the event logger using the configured context
 func (cl *ContextualLogger) Panic() *zerolog.Event {
+	if !cl.filterAllows(PANIC) {
+		return nil
+	}
+	if cl.noPanics || noPanicsGlobal {
+		return cl.addContext(Error()).Str("@bypass", panicBypassMarker)
+	}
 	return cl.addContext(Panic())
 }
 
 // ConfigureGlobalLogger - configures the logger globally
-func ConfigureGlobalLogger(lvl Level, fmt Format) *zerolog.Logger {
+func ConfigureGlobalLogger(lvl Level, fmt Format, options ...ConfigOption) *zerolog.Logger {
 
-	return ConfigureCustomLogger(lvl, fmt, os.Stdout)
+	return ConfigureCustomLogger(lvl, fmt, os.Stdout, options...)
 }
 
 // ConfigureCustomLogger - configures the logger globally
-func ConfigureCustomLogger(lvl Level, fmt Format, out io.Writer) *zerolog.Logger {
+func ConfigureCustomLogger(lvl Level, fmt Format, out io.Writer, options ...ConfigOption) *zerolog.Logger {
 
 	mutex.Lock()
 	defer mutex.Unlock()
 
+	for _, opt := range options {
+		opt()
+	}
+
 	switch lvl {
 	case INFO:
 		zerolog.SetGlobalLevel(zerolog.InfoLevel)
@@ -245,6 +281,9 @@ func SendToStdout(output string) {
 
 // Info - returns the info event logger if any
 func Info() *zerolog.Event {
+	if !globalFilter.allows(INFO) {
+		return nil
+	}
 	if e := logger.Info(); e.Enabled() {
 		return e
 	}
@@ -253,6 +292,9 @@ func Info() *zerolog.Event {
 
 // Debug - returns the debug event logger if any
 func Debug() *zerolog.Event {
+	if !globalFilter.allows(DEBUG) {
+		return nil
+	}
 	if e := logger.Debug(); e.Enabled() {
 		return e
 	}
@@ -261,6 +303,9 @@ func Debug() *zerolog.Event {
 
 // Warn - returns the error event logger if any
 func Warn() *zerolog.Event {
+	if !globalFilter.allows(WARN) {
+		return nil
+	}
 	if e := logger.Warn(); e.Enabled() {
 		return e
 	}
@@ -269,6 +314,9 @@ func Warn() *zerolog.Event {
 
 // Error - returns the error event logger if any
 func Error() *zerolog.Event {
+	if !globalFilter.allows(ERROR) {
+		return nil
+	}
 	if e := logger.Error(); e.Enabled() {
 		return e
 	}
@@ -277,6 +325,15 @@ func Error() *zerolog.Event {
 
 // Panic - returns the error event logger if any
 func Panic() *zerolog.Event {
+	if !globalFilter.allows(PANIC) {
+		return nil
+	}
+	if noPanicsGlobal {
+		if e := logger.Error(); e.Enabled() {
+			return e.Str("@bypass", panicBypassMarker)
+		}
+		return nil
+	}
 	if e := logger.Panic(); e.Enabled() {
 		return e
 	}
@@ -285,6 +342,15 @@ func Panic() *zerolog.Event {
 
 // Fatal - returns the error event logger if any
 func Fatal() *zerolog.Event {
+	if !globalFilter.allows(FATAL) {
+		return nil
+	}
+	if noFatalsGlobal {
+		if e := logger.Error(); e.Enabled() {
+			return e.Str("@bypass", fatalBypassMarker)
+		}
+		return nil
+	}
 	if e := logger.Fatal(); e.Enabled() {
 		return e
 	}
@@ -358,69 +424,71 @@ func (cl *ContextualLogger) addContext(eventlLogger *zerolog.Event) *zerolog.Eve
 		return nil
 	}
 
-	for j := 0; j < len(cl.keyValues); j++ {
+	kvs := cl.filter.redact(cl.keyValues)
+
+	for j := 0; j < len(kvs); j++ {
 
-		switch cl.keyValues[j].kind {
+		switch kvs[j].kind {
 
 		case reflect.String:
 
-			eventlLogger = eventlLogger.Str(cl.keyValues[j].key, cl.keyValues[j].rvalue.String())
+			eventlLogger = eventlLogger.Str(kvs[j].key, kvs[j].rvalue.String())
 
 		case reflect.Int:
 
-			eventlLogger = eventlLogger.Int(cl.keyValues[j].key, int(cl.keyValues[j].rvalue.Int()))
+			eventlLogger = eventlLogger.Int(kvs[j].key, int(kvs[j].rvalue.Int()))
 
 		case reflect.Int8:
 
-			eventlLogger = eventlLogger.Int8(cl.keyValues[j].key, int8(cl.keyValues[j].rvalue.Int()))
+			eventlLogger = eventlLogger.Int8(kvs[j].key, int8(kvs[j].rvalue.Int()))
 
 		case reflect.Int16:
 
-			eventlLogger = eventlLogger.Int16(cl.keyValues[j].key, int16(cl.keyValues[j].rvalue.Int()))
+			eventlLogger = eventlLogger.Int16(kvs[j].key, int16(kvs[j].rvalue.Int()))
 
 		case reflect.Int32:
 
-			eventlLogger = eventlLogger.Int32(cl.keyValues[j].key, int32(cl.keyValues[j].rvalue.Int()))
+			eventlLogger = eventlLogger.Int32(kvs[j].key, int32(kvs[j].rvalue.Int()))
 
 		case reflect.Int64:
 
-			eventlLogger = eventlLogger.Int64(cl.keyValues[j].key, cl.keyValues[j].rvalue.Int())
+			eventlLogger = eventlLogger.Int64(kvs[j].key, kvs[j].rvalue.Int())
 
 		case reflect.Uint:
 
-			eventlLogger = eventlLogger.Uint(cl.keyValues[j].key, uint(cl.keyValues[j].rvalue.Uint()))
+			eventlLogger = eventlLogger.Uint(kvs[j].key, uint(kvs[j].rvalue.Uint()))
 
 		case reflect.Uint8:
 
-			eventlLogger = eventlLogger.Uint8(cl.keyValues[j].key, uint8(cl.keyValues[j].rvalue.Uint()))
+			eventlLogger = eventlLogger.Uint8(kvs[j].key, uint8(kvs[j].rvalue.Uint()))
 
 		case reflect.Uint16:
 
-			eventlLogger = eventlLogger.Uint16(cl.keyValues[j].key, uint16(cl.keyValues[j].rvalue.Uint()))
+			eventlLogger = eventlLogger.Uint16(kvs[j].key, uint16(kvs[j].rvalue.Uint()))
 
 		case reflect.Uint32:
 
-			eventlLogger = eventlLogger.Uint32(cl.keyValues[j].key, uint32(cl.keyValues[j].rvalue.Uint()))
+			eventlLogger = eventlLogger.Uint32(kvs[j].key, uint32(kvs[j].rvalue.Uint()))
 
 		case reflect.Uint64:
 
-			eventlLogger = eventlLogger.Uint64(cl.keyValues[j].key, cl.keyValues[j].rvalue.Uint())
+			eventlLogger = eventlLogger.Uint64(kvs[j].key, kvs[j].rvalue.Uint())
 
 		case reflect.Float32:
 
-			eventlLogger = eventlLogger.Float32(cl.keyValues[j].key, float32(cl.keyValues[j].rvalue.Float()))
+			eventlLogger = eventlLogger.Float32(kvs[j].key, float32(kvs[j].rvalue.Float()))
 
 		case reflect.Float64:
 
-			eventlLogger = eventlLogger.Float64(cl.keyValues[j].key, cl.keyValues[j].rvalue.Float())
+			eventlLogger = eventlLogger.Float64(kvs[j].key, kvs[j].rvalue.Float())
 
 		case reflect.Bool:
 
-			eventlLogger = eventlLogger.Bool(cl.keyValues[j].key, cl.keyValues[j].rvalue.Bool())
+			eventlLogger = eventlLogger.Bool(kvs[j].key, kvs[j].rvalue.Bool())
 
 		default:
 
-			eventlLogger = eventlLogger.Interface(cl.keyValues[j].key, cl.keyValues[j].rvalue.Interface())
+			eventlLogger = eventlLogger.Interface(kvs[j].key, kvs[j].rvalue.Interface())
 		}
 	}
 
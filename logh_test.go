@@ -1,13 +1,18 @@
 package logh_test
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
+	"io"
+	"log/slog"
 	"runtime"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 
@@ -164,6 +169,204 @@ func (ts *testSuite) TestErrorLine() {
 	ts.testBufferContents(expected)
 }
 
+func (ts *testSuite) TestFilterLevel() {
+
+	cl := logh.CreateContextualLogger("context11", "test11")
+	cl.SetFilter(logh.NewFilter(logh.FilterLevel(logh.WARN)))
+
+	ts.Nil(cl.Info(), "expected info to be dropped by the filter")
+	ts.NotNil(cl.Warn(), "expected warn to pass the filter")
+}
+
+func (ts *testSuite) TestFilterKeyRedaction() {
+
+	cl := logh.CreateContextualLogger("secret", "abc123")
+	cl.SetFilter(logh.NewFilter(logh.FilterKey("secret")))
+
+	now := time.Now()
+
+	cl.Info().Msg("redaction test")
+
+	expected := fmt.Sprintf(`{"level":"info","secret":"***","time":"%s","message":"redaction test"}`, now.Format(time.RFC3339))
+
+	ts.testBufferContents(expected)
+}
+
+func (ts *testSuite) TestFilterValueUnhashableDoesNotPanic() {
+
+	cl := logh.CreateContextualLogger("tags", []string{"a", "b"})
+	cl.SetFilter(logh.NewFilter(logh.FilterValue("unrelated")))
+
+	ts.NotPanics(func() {
+		cl.Info().Msg("unhashable value")
+	}, "expected FilterValue to skip non-comparable values instead of panicking")
+}
+
+func (ts *testSuite) TestFilterValueRegistrationUnhashableDoesNotPanic() {
+
+	ts.NotPanics(func() {
+		logh.NewFilter(logh.FilterValue([]string{"a", "b"}, map[string]string{"c": "d"}, "unrelated"))
+	}, "expected FilterValue to skip non-comparable values instead of panicking on registration")
+}
+
+func (ts *testSuite) TestPanicBypass() {
+
+	cl := logh.CreateContextualLogger("context12", "test12")
+	cl.WithNoPanics()
+
+	now := time.Now()
+
+	ts.NotPanics(func() {
+		cl.Panic().Msg("bypassed panic")
+	}, "expected the bypassed Panic() to not actually panic")
+
+	expected := fmt.Sprintf(`{"level":"error","context12":"test12","@bypass":"[PANIC BYPASSED]","time":"%s","message":"bypassed panic"}`, now.Format(time.RFC3339))
+
+	ts.testBufferContents(expected)
+}
+
+func (ts *testSuite) TestFatalBypass() {
+
+	cl := logh.CreateContextualLogger("context13", "test13")
+	cl.WithNoFatals()
+
+	now := time.Now()
+
+	cl.Fatal().Msg("bypassed fatal")
+
+	expected := fmt.Sprintf(`{"level":"error","context13":"test13","@bypass":"[FATAL BYPASSED]","time":"%s","message":"bypassed fatal"}`, now.Format(time.RFC3339))
+
+	ts.testBufferContents(expected)
+}
+
+func (ts *testSuite) TestSlogHandler() {
+
+	var buf bytes.Buffer
+	logh.ConfigureHandler(logh.DEBUG, logh.NewSlogHandler(slog.New(slog.NewJSONHandler(&buf, nil))))
+	defer logh.ConfigureHandler(logh.INFO, logh.NewZerologHandler(logh.Logger()))
+
+	cl := logh.CreateContextualLogger("context20", "test20")
+
+	cl.HEvent(logh.INFO).Msg("handler test")
+
+	ts.True(strings.Contains(buf.String(), `"msg":"handler test"`), "expected the message to be emitted through the slog handler")
+	ts.True(strings.Contains(buf.String(), `"context20":"test20"`), "expected context fields to be translated")
+}
+
+func (ts *testSuite) TestHandlerPanicBypass() {
+
+	var buf bytes.Buffer
+	logh.ConfigureHandler(logh.DEBUG, logh.NewSlogHandler(slog.New(slog.NewJSONHandler(&buf, nil))))
+	defer logh.ConfigureHandler(logh.INFO, logh.NewZerologHandler(logh.Logger()))
+
+	cl := logh.CreateContextualLogger("context21", "test21")
+	cl.WithNoPanics()
+
+	ts.NotPanics(func() {
+		cl.HEvent(logh.PANIC).Msg("bypassed handler panic")
+	}, "expected the bypassed Handler panic event to not actually panic")
+
+	ts.True(strings.Contains(buf.String(), `"@bypass":"[PANIC BYPASSED]"`), "expected the bypass marker to be recorded")
+}
+
+func (ts *testSuite) TestLogrHandlerPanicTerminates() {
+
+	logh.ConfigureHandler(logh.DEBUG, logh.NewLogrHandler(logr.Discard()))
+	defer logh.ConfigureHandler(logh.INFO, logh.NewZerologHandler(logh.Logger()))
+
+	cl := logh.CreateContextualLogger("context22", "test22")
+
+	ts.Panics(func() {
+		cl.HEvent(logh.PANIC).Msg("unbypassed handler panic")
+	}, "expected the logr handler to honor the Panic() termination contract")
+}
+
+func (ts *testSuite) TestContextPropagation() {
+
+	cl := logh.CreateContextualLogger("context14", "test14")
+
+	ctx := cl.WithContext(context.Background())
+
+	ts.Equal(cl, logh.FromContext(ctx), "expected the same logger back from the context")
+
+	ncl, err := logh.CreateFromContextCtx(ctx, "context15", "test15")
+	ts.NoError(err, "expects no errors")
+
+	now := time.Now()
+
+	ncl.Info().Msg("from context")
+
+	expected := fmt.Sprintf(`{"level":"info","context14":"test14","context15":"test15","time":"%s","message":"from context"}`, now.Format(time.RFC3339))
+
+	ts.testBufferContents(expected)
+}
+
+func (ts *testSuite) TestContextPropagationKeepsParentLogger() {
+
+	parent := logh.CreateContextualLogger("context16", "test16")
+	child := logh.CreateContextualLogger("context17", "test17")
+
+	ctx := parent.WithContext(context.Background())
+	ctx = child.WithContext(ctx)
+
+	ts.Equal(parent, logh.FromContext(ctx), "expected the parent logger to be preserved")
+}
+
+func (ts *testSuite) TestRingWriterWraparound() {
+
+	rb := logh.NewRingWriter(10)
+
+	rb.Write([]byte("abcdefgh\n"))
+	rb.Write([]byte("ijklmnop\n"))
+
+	ts.Equal("\nijklmnop\n", string(rb.Bytes()), "expected the oldest bytes to be overwritten")
+	ts.Equal([]string{"ijklmnop"}, rb.Lines(5), "expected only the complete trailing line")
+}
+
+func (ts *testSuite) TestRingWriterZeroSize() {
+
+	rb := logh.NewRingWriter(0)
+
+	n, err := rb.Write([]byte("a"))
+
+	ts.Equal(0, n, "expected no bytes written")
+	ts.Equal(io.EOF, err, "expected io.EOF instead of a panic")
+}
+
+func (ts *testSuite) TestSampled() {
+
+	cl := logh.CreateContextualLogger("context18", "test18")
+	sampled := cl.Sampled(3)
+
+	emitted := 0
+	for i := 0; i < 6; i++ {
+		if ev := sampled.Info(); ev != nil {
+			ev.Msg("sampled")
+			emitted++
+		}
+	}
+
+	ts.Equal(2, emitted, "expected 1-of-3 events to be emitted")
+	ts.Equal(uint64(4), sampled.DroppedCount(logh.INFO), "expected the remaining events to be dropped")
+}
+
+func (ts *testSuite) TestRateLimited() {
+
+	cl := logh.CreateContextualLogger("context19", "test19")
+	limited := cl.RateLimited(2, time.Hour)
+
+	emitted := 0
+	for i := 0; i < 4; i++ {
+		if ev := limited.Info(); ev != nil {
+			ev.Msg("rate limited")
+			emitted++
+		}
+	}
+
+	ts.Equal(2, emitted, "expected only burst events to be emitted")
+	ts.Equal(uint64(2), limited.DroppedCount(logh.INFO), "expected events past the burst to be dropped")
+}
+
 func TestSuite(t *testing.T) {
 
 	suite.Run(t, new(testSuite))
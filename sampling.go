@@ -0,0 +1,132 @@
+package logh
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+//
+// Sampling and rate limiting for ContextualLogger, to cap log volume during
+// incident storms without wrapping every call site.
+// @author rnojiri
+//
+
+// numLevels - number of levels tracked by the sampler (DEBUG..PANIC, see levelPriority)
+const numLevels = 6
+
+// sampler - per-level event counters and token buckets backing Sampled/RateLimited
+type sampler struct {
+	every    uint32
+	counters [numLevels]uint64
+
+	burst    int
+	per      time.Duration
+	mutex    sync.Mutex
+	tokens   [numLevels]int
+	lastFill [numLevels]time.Time
+
+	dropped [numLevels]uint64
+}
+
+// Sampled - returns a derived logger that only emits 1-of-every events per level
+func (cl *ContextualLogger) Sampled(every uint32) *ContextualLogger {
+
+	derived := *cl
+	derived.sampler = &sampler{every: every}
+
+	return &derived
+}
+
+// RateLimited - returns a derived logger capped to burst events per `per` duration, per level.
+//
+// SCOPE NOTE: the original ask was a token bucket keyed by (level, message).
+// That isn't achievable under this package's suppression design: Info/Debug/
+// Warn/Error/etc. take no arguments, so a derived logger has nothing but the
+// level to key on at the point where it must decide whether to return nil -
+// the message only exists once the caller chains .Msg("..."), by which time
+// the event (or lack of one) has already been handed back. Doing true
+// per-message keying would mean no longer returning a bare *zerolog.Event
+// from these accessors, which is a breaking change to the whole package's
+// public surface, not a change local to this feature. This is a deliberate,
+// acknowledged reduction to level-only keying, not an oversight - flagging it
+// here since it ships under the (level, message) description from the
+// original request.
+func (cl *ContextualLogger) RateLimited(burst int, per time.Duration) *ContextualLogger {
+
+	derived := *cl
+	derived.sampler = &sampler{burst: burst, per: per}
+
+	return &derived
+}
+
+// DroppedCount - returns how many events this logger has suppressed for the given level
+func (cl *ContextualLogger) DroppedCount(lvl Level) uint64 {
+
+	if cl.sampler == nil {
+		return 0
+	}
+
+	idx, ok := levelPriority[lvl]
+	if !ok {
+		return 0
+	}
+
+	return atomic.LoadUint64(&cl.sampler.dropped[idx])
+}
+
+// allow - decides if the next event at lvl should be emitted
+func (s *sampler) allow(lvl Level) bool {
+
+	if s == nil {
+		return true
+	}
+
+	idx, ok := levelPriority[lvl]
+	if !ok {
+		return true
+	}
+
+	if s.every > 0 {
+		return s.allowSampled(idx)
+	}
+
+	if s.burst > 0 {
+		return s.allowRateLimited(idx)
+	}
+
+	return true
+}
+
+func (s *sampler) allowSampled(idx int) bool {
+
+	count := atomic.AddUint64(&s.counters[idx], 1)
+	if (count-1)%uint64(s.every) == 0 {
+		return true
+	}
+
+	atomic.AddUint64(&s.dropped[idx], 1)
+
+	return false
+}
+
+func (s *sampler) allowRateLimited(idx int) bool {
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := time.Now()
+	if s.lastFill[idx].IsZero() || now.Sub(s.lastFill[idx]) >= s.per {
+		s.tokens[idx] = s.burst
+		s.lastFill[idx] = now
+	}
+
+	if s.tokens[idx] <= 0 {
+		atomic.AddUint64(&s.dropped[idx], 1)
+		return false
+	}
+
+	s.tokens[idx]--
+
+	return true
+}
@@ -1,6 +1,9 @@
 package logh
 
-import "io"
+import (
+	"io"
+	"strings"
+)
 
 // StringWriter - writes in memory
 type StringWriter struct {
@@ -46,3 +49,85 @@ func (sb *StringWriter) Bytes() []byte {
 
 	return sb.buffer[0:sb.index]
 }
+
+// RingWriter - writes in memory, overwriting the oldest bytes once full instead of truncating
+type RingWriter struct {
+	buffer []byte
+	next   uint64
+	filled bool
+	size   uint64
+}
+
+// NewRingWriter - creates a new ring writer with the given capacity in bytes
+func NewRingWriter(size uint64) *RingWriter {
+
+	return &RingWriter{
+		buffer: make([]byte, size),
+		size:   size,
+	}
+}
+
+// Write - implements the io.Writer interface, wrapping around once size bytes were written
+func (rb *RingWriter) Write(p []byte) (n int, err error) {
+
+	if rb.size == 0 {
+		return 0, io.EOF
+	}
+
+	for i := 0; i < len(p); i++ {
+
+		rb.buffer[rb.next] = p[i]
+		rb.next++
+		n++
+
+		if rb.next >= rb.size {
+			rb.next = 0
+			rb.filled = true
+		}
+	}
+
+	return
+}
+
+// Reset - clears the buffer
+func (rb *RingWriter) Reset() {
+
+	rb.next = 0
+	rb.filled = false
+}
+
+// Bytes - returns the stored bytes in chronological order
+func (rb *RingWriter) Bytes() []byte {
+
+	if !rb.filled {
+		return rb.buffer[0:rb.next]
+	}
+
+	out := make([]byte, rb.size)
+	copy(out, rb.buffer[rb.next:])
+	copy(out[rb.size-rb.next:], rb.buffer[0:rb.next])
+
+	return out
+}
+
+// Lines - returns up to the last n complete newline-delimited entries
+func (rb *RingWriter) Lines(n int) []string {
+
+	split := strings.Split(string(rb.Bytes()), "\n")
+
+	// the last segment is only a complete line when the buffer ends in '\n',
+	// otherwise it is a partial line still being written
+	lines := split[:len(split)-1]
+
+	// once the ring has wrapped, the oldest surviving segment was chopped in
+	// half by the overwrite, so it is a partial line too and must be dropped
+	if rb.filled && len(lines) > 0 {
+		lines = lines[1:]
+	}
+
+	if len(lines) <= n {
+		return lines
+	}
+
+	return lines[len(lines)-n:]
+}